@@ -63,6 +63,24 @@ const (
 	BlockCallout = "callout"
 	// BlockTableOfContents is table of contents
 	BlockTableOfContents = "table_of_contents"
+	// BlockEquation is a block-level latex equation
+	BlockEquation = "equation"
+	// BlockBreadcrumb shows the page's position in the page hierarchy
+	BlockBreadcrumb = "breadcrumb"
+	// BlockTemplate is a "New" template button block
+	BlockTemplate = "template"
+	// BlockChildDatabase is an inline database embedded in a page
+	BlockChildDatabase = "child_database"
+	// BlockLinkToPage is a link to another page, distinct from BlockPage
+	// which also covers sub-pages and embedded pages
+	BlockLinkToPage = "link_to_page"
+	// BlockAudio is an embedded audio file
+	BlockAudio = "audio"
+	// BlockTransclusionContainer is the block that hosts the content
+	// shared by one or more BlockTransclusionReference blocks ("Sync Block")
+	BlockTransclusionContainer = "transclusion_container"
+	// BlockTransclusionReference is a synced copy of a BlockTransclusionContainer
+	BlockTransclusionReference = "transclusion_reference"
 )
 
 // BlockPageType defines a type of BlockPage block
@@ -124,6 +142,8 @@ type Block struct {
 
 	// maps ContentIDs array
 	Content []*Block `json:"content_resolved,omitempty"`
+	// maps DiscussionIDs array
+	Discussions []*Discussion `json:"discussions_resolved,omitempty"`
 	// this is for some types like TypePage, TypeText, TypeHeader etc.
 	InlineContent []*TextSpan `json:"inline_text,omitempty"`
 
@@ -158,6 +178,16 @@ type Block struct {
 	Code         string `json:"code,omitempty"`
 	CodeLanguage string `json:"code_language,omitempty"`
 
+	// for BlockEquation, a latex string
+	Equation string `json:"equation,omitempty"`
+
+	// for BlockTransclusionReference, the id of the BlockTransclusionContainer
+	// this block is synced from
+	SyncedFromBlockID string `json:"synced_from_block_id,omitempty"`
+
+	// for BlockLinkToPage, the id of the page being linked to
+	LinkedPageID string `json:"linked_page_id,omitempty"`
+
 	// for BlockCollectionView
 	// It looks like the info about which view is selected is stored in browser
 	CollectionViews []*CollectionViewInfo `json:"collection_views,omitempty"`
@@ -172,6 +202,12 @@ type Block struct {
 	FormatEmbed    *FormatEmbed    `json:"format_embed,omitempty"`
 	FormatToggle   *FormatToggle   `json:"format_toggle,omitempty"`
 	FormatHeader   *FormatHeader   `json:"format_header,omitempty"`
+	FormatAudio    *FormatAudio    `json:"format_audio,omitempty"`
+
+	// for BlockTransclusionReference, points at the BlockTransclusionContainer
+	// this block is synced from
+	FormatTransclusionReference *FormatTransclusionReference `json:"format_transclusion_reference,omitempty"`
+	FormatLinkToPage            *FormatLinkToPage            `json:"format_link_to_page,omitempty"`
 }
 
 // CollectionViewInfo describes a particular view of the collection
@@ -202,6 +238,9 @@ func (b *Block) IsLinkToPage() bool {
 
 // GetPageType returns type of this page
 func (b *Block) GetPageType() BlockPageType {
+	if b.Type == BlockLinkToPage {
+		return BlockPageLink
+	}
 	if b.Parent == nil {
 		return BlockPageTopLevel
 	}
@@ -320,6 +359,24 @@ type FormatEmbed struct {
 	DisplaySource      string  `json:"display_source"`
 }
 
+// FormatAudio describes format for BlockAudio
+type FormatAudio struct {
+	DisplaySource string `json:"display_source"`
+}
+
+// FormatTransclusionReference describes format for BlockTransclusionReference
+type FormatTransclusionReference struct {
+	// PointerID is the id of the BlockTransclusionContainer block
+	PointerID string `json:"id"`
+	// PointerTable is usually "block"
+	PointerTable string `json:"table"`
+}
+
+// FormatLinkToPage describes format for BlockLinkToPage
+type FormatLinkToPage struct {
+	PageID string `json:"page_id"`
+}
+
 // Permission describes user permissions
 type Permission struct {
 	Role   string  `json:"role"`
@@ -383,6 +440,8 @@ func parseProperties(block *Block) error {
 			block.TitleFull, err = ParseTextSpans(title)
 		case BlockCode:
 			block.Code, err = getFirstInlineBlock(title)
+		case BlockEquation:
+			block.Equation, err = getFirstInlineBlock(title)
 		default:
 			block.InlineContent, err = ParseTextSpans(title)
 		}
@@ -495,6 +554,26 @@ func parseFormat(block *Block) error {
 		if err == nil {
 			block.FormatToggle = &format
 		}
+	case BlockAudio:
+		var format FormatAudio
+		err = json.Unmarshal(block.FormatRaw, &format)
+		if err == nil {
+			block.FormatAudio = &format
+		}
+	case BlockTransclusionReference:
+		var format FormatTransclusionReference
+		err = json.Unmarshal(block.FormatRaw, &format)
+		if err == nil {
+			block.FormatTransclusionReference = &format
+			block.SyncedFromBlockID = format.PointerID
+		}
+	case BlockLinkToPage:
+		var format FormatLinkToPage
+		err = json.Unmarshal(block.FormatRaw, &format)
+		if err == nil {
+			block.FormatLinkToPage = &format
+			block.LinkedPageID = format.PageID
+		}
 	}
 
 	if err != nil {
@@ -0,0 +1,103 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func loadTestBlock(t *testing.T, path string) *Block {
+	t.Helper()
+	d, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q) failed with %s", path, err)
+	}
+	var b Block
+	if err := json.Unmarshal(d, &b); err != nil {
+		t.Fatalf("json.Unmarshal(%q) failed with %s", path, err)
+	}
+	if err := parseProperties(&b); err != nil {
+		t.Fatalf("parseProperties(%q) failed with %s", path, err)
+	}
+	if err := parseFormat(&b); err != nil {
+		t.Fatalf("parseFormat(%q) failed with %s", path, err)
+	}
+	return &b
+}
+
+func TestParseEquationBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/equation_block.json")
+	if b.Type != BlockEquation {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockEquation)
+	}
+	if b.Equation != "E = mc^2" {
+		t.Fatalf("got Equation %q, want %q", b.Equation, "E = mc^2")
+	}
+}
+
+func TestParseBreadcrumbBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/breadcrumb_block.json")
+	if b.Type != BlockBreadcrumb {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockBreadcrumb)
+	}
+}
+
+func TestParseTransclusionReferenceBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/transclusion_reference_block.json")
+	if b.Type != BlockTransclusionReference {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockTransclusionReference)
+	}
+	wantSyncedFrom := "tc000000-0000-0000-0000-000000000001"
+	if b.SyncedFromBlockID != wantSyncedFrom {
+		t.Fatalf("got SyncedFromBlockID %q, want %q", b.SyncedFromBlockID, wantSyncedFrom)
+	}
+	if b.FormatTransclusionReference == nil || b.FormatTransclusionReference.PointerTable != "block" {
+		t.Fatalf("got FormatTransclusionReference %+v, want PointerTable %q", b.FormatTransclusionReference, "block")
+	}
+}
+
+func TestParseTemplateBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/template_block.json")
+	if b.Type != BlockTemplate {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockTemplate)
+	}
+	if got := GetInlineText(b.InlineContent); got != "New task" {
+		t.Fatalf("got inline text %q, want %q", got, "New task")
+	}
+}
+
+func TestParseChildDatabaseBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/child_database_block.json")
+	if b.Type != BlockChildDatabase {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockChildDatabase)
+	}
+	wantCollectionID := "col00000-0000-0000-0000-000000000001"
+	if b.CollectionID != wantCollectionID {
+		t.Fatalf("got CollectionID %q, want %q", b.CollectionID, wantCollectionID)
+	}
+}
+
+func TestParseLinkToPageBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/link_to_page_block.json")
+	if b.Type != BlockLinkToPage {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockLinkToPage)
+	}
+	wantLinkedPageID := "pg000000-0000-0000-0000-000000000001"
+	if b.LinkedPageID != wantLinkedPageID {
+		t.Fatalf("got LinkedPageID %q, want %q", b.LinkedPageID, wantLinkedPageID)
+	}
+	if got := b.GetPageType(); got != BlockPageLink {
+		t.Fatalf("got GetPageType() %v, want %v (should not require a resolved Parent)", got, BlockPageLink)
+	}
+}
+
+func TestParseAudioBlock(t *testing.T) {
+	b := loadTestBlock(t, "testdata/audio_block.json")
+	if b.Type != BlockAudio {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockAudio)
+	}
+	wantURL := "https://s3.example.com/audio.mp3"
+	if b.FormatAudio == nil || b.FormatAudio.DisplaySource != wantURL {
+		t.Fatalf("got FormatAudio %+v, want DisplaySource %q", b.FormatAudio, wantURL)
+	}
+}
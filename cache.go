@@ -0,0 +1,118 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cache stores parsed blocks keyed by ID, letting an incremental
+// downloader skip re-fetching blocks whose Version hasn't changed.
+type Cache interface {
+	// Get returns the cached block for id, if any.
+	Get(id string) (*Block, bool)
+	// Put stores (or replaces) the cached block.
+	Put(b *Block)
+	// Versions returns the cached Version of every block currently
+	// stored, keyed by ID.
+	Versions() map[string]int64
+}
+
+// FileCache is a Cache backed by one JSON file per block, named
+// <id>.json, under Root.
+type FileCache struct {
+	Root string
+}
+
+// NewFileCache returns a FileCache rooted at dir, creating dir if it
+// doesn't already exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileCache{Root: dir}, nil
+}
+
+var _ Cache = (*FileCache)(nil)
+
+// isSafeCacheID reports whether id is safe to use as a single path
+// component under Root. Block IDs are expected to be UUIDs, but we don't
+// trust that blindly: an id containing a path separator or ".." could
+// otherwise make path() escape Root (path traversal, up to and including
+// arbitrary file read/write).
+func isSafeCacheID(id string) bool {
+	if id == "" || id == "." || id == ".." {
+		return false
+	}
+	return !strings.ContainsAny(id, `/\`)
+}
+
+func (c *FileCache) path(id string) string {
+	return filepath.Join(c.Root, id+".json")
+}
+
+// Get implements Cache.
+func (c *FileCache) Get(id string) (*Block, bool) {
+	if !isSafeCacheID(id) {
+		return nil, false
+	}
+	d, err := os.ReadFile(c.path(id))
+	if err != nil {
+		return nil, false
+	}
+	var b Block
+	if err := json.Unmarshal(d, &b); err != nil {
+		return nil, false
+	}
+	return &b, true
+}
+
+// Put implements Cache. The write is atomic: it writes to a temp file
+// next to the final path and renames it over, so a crash mid-write never
+// leaves a corrupt cache entry. Blocks whose ID isn't a safe single path
+// component (see isSafeCacheID) are silently not cached, same as any
+// other Put failure.
+func (c *FileCache) Put(b *Block) {
+	if !isSafeCacheID(b.ID) {
+		return
+	}
+	d, err := json.Marshal(b)
+	if err != nil {
+		return
+	}
+	final := c.path(b.ID)
+	tmp := final + ".tmp"
+	if err := os.WriteFile(tmp, d, 0644); err != nil {
+		return
+	}
+	_ = os.Rename(tmp, final)
+}
+
+// Versions implements Cache by reading the id/version pair out of every
+// cached block file.
+func (c *FileCache) Versions() map[string]int64 {
+	versions := map[string]int64{}
+	entries, err := os.ReadDir(c.Root)
+	if err != nil {
+		return versions
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		d, err := os.ReadFile(filepath.Join(c.Root, e.Name()))
+		if err != nil {
+			continue
+		}
+		var v struct {
+			ID      string `json:"id"`
+			Version int64  `json:"version"`
+		}
+		if err := json.Unmarshal(d, &v); err != nil {
+			continue
+		}
+		versions[v.ID] = v.Version
+	}
+	return versions
+}
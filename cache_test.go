@@ -0,0 +1,61 @@
+package notionapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileCachePutGetRoundTrip(t *testing.T) {
+	c, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+	want := &Block{ID: "block-1", Version: 3, Type: BlockText}
+	c.Put(want)
+
+	got, ok := c.Get("block-1")
+	if !ok {
+		t.Fatalf("Get(%q) = _, false, want true", want.ID)
+	}
+	if got.ID != want.ID || got.Version != want.Version || got.Type != want.Type {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	versions := c.Versions()
+	if versions["block-1"] != 3 {
+		t.Fatalf("got Versions()[block-1] = %d, want 3", versions["block-1"])
+	}
+}
+
+func TestFileCacheGetMiss(t *testing.T) {
+	c, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+	if _, ok := c.Get("does-not-exist"); ok {
+		t.Fatalf("Get(%q) = _, true, want false", "does-not-exist")
+	}
+}
+
+func TestFileCacheRejectsPathTraversalIDs(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+
+	badIDs := []string{"../../etc/passwd", "..", "a/b", `a\b`, ""}
+	for _, id := range badIDs {
+		c.Put(&Block{ID: id})
+		if _, ok := c.Get(id); ok {
+			t.Fatalf("Get(%q) = _, true, want false (unsafe id)", id)
+		}
+	}
+
+	// Put must not have written anything outside dir either.
+	escaped := filepath.Join(dir, "../../etc/passwd.json")
+	if _, err := os.Stat(escaped); err == nil {
+		t.Fatalf("Put wrote %s outside the cache root", escaped)
+	}
+}
@@ -0,0 +1,116 @@
+package notionapi
+
+// Discussion represents a thread of comments attached to a block, as
+// referenced by Block.DiscussionIDs.
+type Discussion struct {
+	ID          string `json:"id"`
+	ParentID    string `json:"parent_id"`
+	ParentTable string `json:"parent_table"`
+	// List of comment ids that make up this discussion, in order.
+	// Use Comments to get the corresponding objects.
+	CommentIDs []string `json:"comments,omitempty"`
+	IsResolved bool     `json:"resolved,omitempty"`
+
+	// Values calculated by us
+
+	// maps CommentIDs array
+	Comments []*Comment `json:"comments_resolved,omitempty"`
+}
+
+// Comment is a single comment within a Discussion.
+type Comment struct {
+	ID          string                 `json:"id"`
+	ParentID    string                 `json:"parent_id"`
+	ParentTable string                 `json:"parent_table"`
+	CreatedBy   string                 `json:"created_by"`
+	CreatedTime int64                  `json:"created_time"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+
+	// Values calculated by us
+	InlineContent []*TextSpan `json:"-"`
+}
+
+// Text returns the flattened, unformatted text of the comment.
+func (c *Comment) Text() string {
+	return GetInlineText(c.InlineContent)
+}
+
+func parseComment(c *Comment) error {
+	title, ok := c.Properties["title"]
+	if !ok {
+		return nil
+	}
+	var err error
+	c.InlineContent, err = ParseTextSpans(title)
+	return err
+}
+
+// OpenDiscussions returns the discussions attached to b that have not
+// been marked resolved.
+func (b *Block) OpenDiscussions() []*Discussion {
+	var open []*Discussion
+	for _, d := range b.Discussions {
+		if !d.IsResolved {
+			open = append(open, d)
+		}
+	}
+	return open
+}
+
+// ResolveDiscussions wires up b.Discussions (and recurses into b.Content)
+// from the DiscussionIDs/CommentIDs references, given records fetched
+// from the discussion and comment tables. It mirrors how ContentIDs is
+// turned into Content during page loading, and is the entry point a
+// downloader calls once it has fetched those tables (see
+// DownloadPageIncremental, which calls it when its RecordFetcher also
+// implements DiscussionFetcher).
+func ResolveDiscussions(b *Block, discussions map[string]*Discussion, comments map[string]*Comment) error {
+	for _, id := range b.DiscussionIDs {
+		d, ok := discussions[id]
+		if !ok {
+			continue
+		}
+		for _, commentID := range d.CommentIDs {
+			c, ok := comments[commentID]
+			if !ok {
+				continue
+			}
+			if err := parseComment(c); err != nil {
+				return err
+			}
+			d.Comments = append(d.Comments, c)
+		}
+		b.Discussions = append(b.Discussions, d)
+	}
+	for _, child := range b.Content {
+		if err := ResolveDiscussions(child, discussions, comments); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectDiscussionIDs gathers every DiscussionIDs entry found in b and
+// its resolved Content, for use as the input to a DiscussionFetcher.
+func collectDiscussionIDs(b *Block) []string {
+	var ids []string
+	var walk func(*Block)
+	walk = func(b *Block) {
+		ids = append(ids, b.DiscussionIDs...)
+		for _, child := range b.Content {
+			walk(child)
+		}
+	}
+	walk(b)
+	return ids
+}
+
+// DiscussionFetcher is an optional capability a RecordFetcher can
+// implement to let DownloadPageIncremental resolve Discussions/Comments
+// alongside Content in the same pass.
+type DiscussionFetcher interface {
+	// GetDiscussions fetches discussion records by id.
+	GetDiscussions(ids []string) (map[string]*Discussion, error)
+	// GetComments fetches comment records by id.
+	GetComments(ids []string) (map[string]*Comment, error)
+}
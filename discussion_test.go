@@ -0,0 +1,45 @@
+package notionapi
+
+import "testing"
+
+func TestResolveDiscussions(t *testing.T) {
+	root := &Block{
+		ID:            "root",
+		DiscussionIDs: []string{"disc-1"},
+	}
+	child := &Block{
+		ID:            "child",
+		DiscussionIDs: []string{"disc-2"},
+	}
+	root.Content = []*Block{child}
+
+	discussions := map[string]*Discussion{
+		"disc-1": {ID: "disc-1", CommentIDs: []string{"comment-1"}},
+		"disc-2": {ID: "disc-2", IsResolved: true, CommentIDs: []string{"comment-2"}},
+	}
+	comments := map[string]*Comment{
+		"comment-1": {ID: "comment-1", Properties: map[string]interface{}{"title": [][]interface{}{{"hello"}}}},
+		"comment-2": {ID: "comment-2", Properties: map[string]interface{}{"title": [][]interface{}{{"resolved already"}}}},
+	}
+
+	if err := ResolveDiscussions(root, discussions, comments); err != nil {
+		t.Fatalf("ResolveDiscussions failed with %s", err)
+	}
+
+	if len(root.Discussions) != 1 || root.Discussions[0].ID != "disc-1" {
+		t.Fatalf("got root.Discussions %+v, want [disc-1]", root.Discussions)
+	}
+	if got := root.Discussions[0].Comments[0].Text(); got != "hello" {
+		t.Fatalf("got comment text %q, want %q", got, "hello")
+	}
+	if len(root.OpenDiscussions()) != 1 {
+		t.Fatalf("got %d open discussions on root, want 1", len(root.OpenDiscussions()))
+	}
+
+	if len(child.Discussions) != 1 || child.Discussions[0].ID != "disc-2" {
+		t.Fatalf("got child.Discussions %+v, want [disc-2]", child.Discussions)
+	}
+	if len(child.OpenDiscussions()) != 0 {
+		t.Fatalf("got %d open discussions on child, want 0 (disc-2 is resolved)", len(child.OpenDiscussions()))
+	}
+}
@@ -0,0 +1,157 @@
+package notionapi
+
+import "fmt"
+
+// RecordFetcher is the subset of backend access DownloadPageIncremental
+// needs. The private API's backend client is expected to implement it
+// (GetRecordVersions maps to the backend's getRecordValues call).
+type RecordFetcher interface {
+	// GetRecordVersions returns the current Version of each given
+	// block id, without fetching the full records.
+	GetRecordVersions(ids []string) (map[string]int64, error)
+	// GetRecords fetches and returns full, unparsed blocks for ids
+	// (parseProperties/parseFormat have not yet been run on them).
+	GetRecords(ids []string) (map[string]*Block, error)
+}
+
+// DownloadStats reports how much of an incremental download was served
+// from Cache versus fetched over the wire.
+type DownloadStats struct {
+	Hits      int // blocks whose cached Version was already current
+	Misses    int // blocks that needed a version check plus a fetch
+	Refetched int // blocks actually fetched
+}
+
+// DownloadPageIncremental fetches pageID and its transitive content,
+// consulting cache first and only refetching blocks whose Version has
+// changed or that are missing entirely. It returns the resolved page
+// tree (Content wired up, same as a full download) along with stats a
+// caller can use to skip downstream work (e.g. a Hugo rebuild) when
+// nothing changed.
+func DownloadPageIncremental(fetcher RecordFetcher, cache Cache, pageID string) (*Block, *DownloadStats, error) {
+	stats := &DownloadStats{}
+	seen := map[string]bool{}
+	blocks := map[string]*Block{}
+
+	queue := []string{pageID}
+	for len(queue) > 0 {
+		batch := dedupNew(queue, seen)
+		queue = nil
+		if len(batch) == 0 {
+			continue
+		}
+
+		versions, err := fetcher.GetRecordVersions(batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		cached := cache.Versions()
+
+		var staleIDs []string
+		for _, id := range batch {
+			if b, ok := cache.Get(id); ok && cached[id] == versions[id] {
+				blocks[id] = b
+				stats.Hits++
+				continue
+			}
+			stats.Misses++
+			staleIDs = append(staleIDs, id)
+		}
+
+		if len(staleIDs) > 0 {
+			fresh, err := fetcher.GetRecords(staleIDs)
+			if err != nil {
+				return nil, nil, err
+			}
+			for id, b := range fresh {
+				if err := parseProperties(b); err != nil {
+					return nil, nil, err
+				}
+				if err := parseFormat(b); err != nil {
+					return nil, nil, err
+				}
+				cache.Put(b)
+				blocks[id] = b
+				stats.Refetched++
+			}
+		}
+
+		for _, id := range batch {
+			b, ok := blocks[id]
+			if !ok {
+				continue
+			}
+			queue = append(queue, b.ContentIDs...)
+		}
+	}
+
+	page, ok := blocks[pageID]
+	if !ok {
+		return nil, nil, fmt.Errorf("notionapi: page %s not found", pageID)
+	}
+	resolveContentFromCache(page, blocks)
+
+	if df, ok := fetcher.(DiscussionFetcher); ok {
+		if err := resolveDiscussionsFromFetcher(df, page); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return page, stats, nil
+}
+
+// resolveDiscussionsFromFetcher fetches the discussion/comment tables for
+// every DiscussionIDs reference in page's tree and resolves them onto
+// page.Discussions (and each descendant's), via ResolveDiscussions.
+func resolveDiscussionsFromFetcher(df DiscussionFetcher, page *Block) error {
+	discussionIDs := collectDiscussionIDs(page)
+	if len(discussionIDs) == 0 {
+		return nil
+	}
+
+	discussions, err := df.GetDiscussions(discussionIDs)
+	if err != nil {
+		return err
+	}
+
+	var commentIDs []string
+	for _, d := range discussions {
+		commentIDs = append(commentIDs, d.CommentIDs...)
+	}
+	comments, err := df.GetComments(commentIDs)
+	if err != nil {
+		return err
+	}
+
+	return ResolveDiscussions(page, discussions, comments)
+}
+
+// dedupNew returns the ids in queue not already present in seen, marking
+// them seen as it goes.
+func dedupNew(queue []string, seen map[string]bool) []string {
+	var out []string
+	for _, id := range queue {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// resolveContentFromCache wires up b.Content (and recurses) from
+// b.ContentIDs using blocks already fetched into the blocks map, the same
+// way a full page download resolves Content.
+func resolveContentFromCache(b *Block, blocks map[string]*Block) {
+	b.Content = nil
+	for _, id := range b.ContentIDs {
+		child, ok := blocks[id]
+		if !ok {
+			continue
+		}
+		child.Parent = b
+		resolveContentFromCache(child, blocks)
+		b.Content = append(b.Content, child)
+	}
+}
@@ -0,0 +1,112 @@
+package notionapi
+
+import "testing"
+
+// fakeRecordFetcher is an in-memory RecordFetcher backed by a fixed set of
+// blocks, with a counter so tests can assert which ids were actually
+// fetched (as opposed to served from cache).
+type fakeRecordFetcher struct {
+	blocks  map[string]*Block
+	fetched []string
+}
+
+func (f *fakeRecordFetcher) GetRecordVersions(ids []string) (map[string]int64, error) {
+	out := map[string]int64{}
+	for _, id := range ids {
+		if b, ok := f.blocks[id]; ok {
+			out[id] = b.Version
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRecordFetcher) GetRecords(ids []string) (map[string]*Block, error) {
+	out := map[string]*Block{}
+	for _, id := range ids {
+		if b, ok := f.blocks[id]; ok {
+			// Return a copy, the same way a real backend returns a
+			// freshly decoded block rather than an internal pointer.
+			cp := *b
+			out[id] = &cp
+			f.fetched = append(f.fetched, id)
+		}
+	}
+	return out, nil
+}
+
+func newFakeFetcher() *fakeRecordFetcher {
+	root := &Block{ID: "root", Version: 1, Type: BlockPage, ContentIDs: []string{"child"}}
+	child := &Block{ID: "child", Version: 1, Type: BlockText}
+	return &fakeRecordFetcher{blocks: map[string]*Block{"root": root, "child": child}}
+}
+
+func TestDownloadPageIncrementalFirstRunIsAllMisses(t *testing.T) {
+	fetcher := newFakeFetcher()
+	cache, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+
+	page, stats, err := DownloadPageIncremental(fetcher, cache, "root")
+	if err != nil {
+		t.Fatalf("DownloadPageIncremental failed with %s", err)
+	}
+	if page.ID != "root" || len(page.Content) != 1 || page.Content[0].ID != "child" {
+		t.Fatalf("got page %+v, want root with resolved child content", page)
+	}
+	if stats.Hits != 0 || stats.Misses != 2 || stats.Refetched != 2 {
+		t.Fatalf("got stats %+v, want Hits=0 Misses=2 Refetched=2", stats)
+	}
+}
+
+func TestDownloadPageIncrementalSecondRunIsAllHits(t *testing.T) {
+	fetcher := newFakeFetcher()
+	cache, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+
+	if _, _, err := DownloadPageIncremental(fetcher, cache, "root"); err != nil {
+		t.Fatalf("first DownloadPageIncremental failed with %s", err)
+	}
+	fetcher.fetched = nil
+
+	page, stats, err := DownloadPageIncremental(fetcher, cache, "root")
+	if err != nil {
+		t.Fatalf("second DownloadPageIncremental failed with %s", err)
+	}
+	if page.ID != "root" {
+		t.Fatalf("got page.ID %q, want %q", page.ID, "root")
+	}
+	if stats.Hits != 2 || stats.Misses != 0 || stats.Refetched != 0 {
+		t.Fatalf("got stats %+v, want Hits=2 Misses=0 Refetched=0", stats)
+	}
+	if len(fetcher.fetched) != 0 {
+		t.Fatalf("got fetched %v, want none refetched on a cache hit", fetcher.fetched)
+	}
+}
+
+func TestDownloadPageIncrementalRefetchesChangedVersion(t *testing.T) {
+	fetcher := newFakeFetcher()
+	cache, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache failed with %s", err)
+	}
+	if _, _, err := DownloadPageIncremental(fetcher, cache, "root"); err != nil {
+		t.Fatalf("first DownloadPageIncremental failed with %s", err)
+	}
+
+	fetcher.blocks["child"].Version = 2
+	fetcher.fetched = nil
+
+	_, stats, err := DownloadPageIncremental(fetcher, cache, "root")
+	if err != nil {
+		t.Fatalf("second DownloadPageIncremental failed with %s", err)
+	}
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Refetched != 1 {
+		t.Fatalf("got stats %+v, want Hits=1 Misses=1 Refetched=1", stats)
+	}
+	if len(fetcher.fetched) != 1 || fetcher.fetched[0] != "child" {
+		t.Fatalf("got fetched %v, want only [child] refetched", fetcher.fetched)
+	}
+}
@@ -0,0 +1,220 @@
+package notionapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	officialAPIBaseURL = "https://api.notion.com/v1"
+	officialAPIVersion = "2022-06-28"
+)
+
+// OfficialClient talks to Notion's official public REST API
+// (api.notion.com), as opposed to the private notion.so API the rest of
+// this package targets. Responses are normalized into this package's
+// Block/FormatPage/FormatImage/TextSpan types, so renderers and Walk
+// visitors work unchanged regardless of which client produced the tree.
+type OfficialClient struct {
+	// APIToken is the integration's bearer token.
+	APIToken string
+	// NotionVersion is sent as the Notion-Version header. Defaults to
+	// officialAPIVersion if empty.
+	NotionVersion string
+	// HTTPClient is used to make requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// BaseURL overrides the API root, mainly for tests. Defaults to
+	// officialAPIBaseURL.
+	BaseURL string
+}
+
+// Mutator is the write side of the official API. It's an interface,
+// rather than concrete OfficialClient methods, so callers can mock it in
+// tests without making real HTTP requests.
+type Mutator interface {
+	// AppendBlockChildren appends children to the end of parentID's
+	// children list.
+	AppendBlockChildren(parentID string, children []*Block) error
+	// CreatePage creates a page under parentID (a page or, when
+	// parentIsDatabase is true, a database) with the given properties
+	// and returns the created page.
+	CreatePage(parentID string, parentIsDatabase bool, properties map[string]interface{}) (*Block, error)
+	// UpdatePageProperties patches a page's properties.
+	UpdatePageProperties(pageID string, properties map[string]interface{}) error
+}
+
+var _ Mutator = (*OfficialClient)(nil)
+
+func (c *OfficialClient) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *OfficialClient) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return officialAPIBaseURL
+}
+
+func (c *OfficialClient) notionVersion() string {
+	if c.NotionVersion != "" {
+		return c.NotionVersion
+	}
+	return officialAPIVersion
+}
+
+func (c *OfficialClient) doJSON(method, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.baseURL()+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.APIToken)
+	req.Header.Set("Notion-Version", c.notionVersion())
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	d, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode >= 300 {
+		return fmt.Errorf("notionapi: official API request %s %s failed with status %d: %s", method, path, rsp.StatusCode, string(d))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(d, out)
+}
+
+// officialListResponse is the envelope shared by paginated list endpoints
+// like /v1/blocks/{id}/children, /v1/databases/{id}/query and /v1/search.
+type officialListResponse struct {
+	Results    []json.RawMessage `json:"results"`
+	HasMore    bool              `json:"has_more"`
+	NextCursor string            `json:"next_cursor"`
+}
+
+// GetBlockChildren fetches all children of blockID, following
+// start_cursor/next_cursor pagination, converted to *Block. ParentID is
+// set on each; Content is left for the caller (see GetPage).
+func (c *OfficialClient) GetBlockChildren(blockID string) ([]*Block, error) {
+	var out []*Block
+	cursor := ""
+	for {
+		query := url.Values{"page_size": {"100"}}
+		if cursor != "" {
+			query.Set("start_cursor", cursor)
+		}
+		path := fmt.Sprintf("/blocks/%s/children?%s", url.PathEscape(blockID), query.Encode())
+		var rsp officialListResponse
+		if err := c.doJSON(http.MethodGet, path, nil, &rsp); err != nil {
+			return nil, err
+		}
+		for _, raw := range rsp.Results {
+			b, err := officialBlockToBlock(raw)
+			if err != nil {
+				return nil, err
+			}
+			b.ParentID = blockID
+			out = append(out, b)
+		}
+		if !rsp.HasMore {
+			break
+		}
+		cursor = rsp.NextCursor
+	}
+	return out, nil
+}
+
+// GetPage fetches pageID's own properties plus its children, recursively
+// (not following child_page/child_database, same as the private API's
+// GetPageType semantics), and returns a fully resolved *Block tree.
+func (c *OfficialClient) GetPage(pageID string) (*Block, error) {
+	var raw json.RawMessage
+	if err := c.doJSON(http.MethodGet, "/pages/"+url.PathEscape(pageID), nil, &raw); err != nil {
+		return nil, err
+	}
+	page, err := officialPageToBlock(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.fillChildren(page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (c *OfficialClient) fillChildren(b *Block) error {
+	children, err := c.GetBlockChildren(b.ID)
+	if err != nil {
+		return err
+	}
+	b.Content = children
+	for _, child := range children {
+		child.Parent = b
+		if child.Type == BlockPage || child.Type == BlockChildDatabase {
+			continue
+		}
+		if err := c.fillChildren(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendBlockChildren implements Mutator.
+func (c *OfficialClient) AppendBlockChildren(parentID string, children []*Block) error {
+	payload := struct {
+		Children []json.RawMessage `json:"children"`
+	}{}
+	for _, b := range children {
+		raw, err := blockToOfficialBlock(b)
+		if err != nil {
+			return err
+		}
+		payload.Children = append(payload.Children, raw)
+	}
+	return c.doJSON(http.MethodPatch, "/blocks/"+url.PathEscape(parentID)+"/children", payload, nil)
+}
+
+// CreatePage implements Mutator.
+func (c *OfficialClient) CreatePage(parentID string, parentIsDatabase bool, properties map[string]interface{}) (*Block, error) {
+	parentKey := "page_id"
+	if parentIsDatabase {
+		parentKey = "database_id"
+	}
+	payload := map[string]interface{}{
+		"parent":     map[string]string{parentKey: parentID},
+		"properties": properties,
+	}
+	var raw json.RawMessage
+	if err := c.doJSON(http.MethodPost, "/pages", payload, &raw); err != nil {
+		return nil, err
+	}
+	return officialPageToBlock(raw)
+}
+
+// UpdatePageProperties implements Mutator.
+func (c *OfficialClient) UpdatePageProperties(pageID string, properties map[string]interface{}) error {
+	payload := map[string]interface{}{"properties": properties}
+	return c.doJSON(http.MethodPatch, "/pages/"+url.PathEscape(pageID), payload, nil)
+}
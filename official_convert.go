@@ -0,0 +1,406 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// officialBlockTypeToType maps the official public API's per-block type
+// strings (the key of the block's own JSON object, e.g. "paragraph",
+// "heading_1") to this package's Block* constants.
+var officialBlockTypeToType = map[string]string{
+	"paragraph":          BlockText,
+	"heading_1":          BlockHeader,
+	"heading_2":          BlockSubHeader,
+	"heading_3":          BlockSubSubHeader,
+	"bulleted_list_item": BlockBulletedList,
+	"numbered_list_item": BlockNumberedList,
+	"to_do":              BlockTodo,
+	"toggle":             BlockToggle,
+	"code":               BlockCode,
+	"quote":              BlockQuote,
+	"callout":            BlockCallout,
+	"divider":            BlockDivider,
+	"bookmark":           BlockBookmark,
+	"embed":              BlockEmbed,
+	"link_preview":       BlockEmbed,
+	"image":              BlockImage,
+	"video":              BlockVideo,
+	"pdf":                BlockPDF,
+	"file":               BlockFile,
+	"audio":              BlockAudio,
+	"table_of_contents":  BlockTableOfContents,
+	"breadcrumb":         BlockBreadcrumb,
+	"equation":           BlockEquation,
+	"child_page":         BlockPage,
+	"child_database":     BlockChildDatabase,
+	// "synced_block" is ambiguous until its body is parsed: the
+	// original block has a null synced_from and should be a
+	// BlockTransclusionContainer, while a synced copy has synced_from
+	// set and should be a BlockTransclusionReference. This entry is the
+	// fallback for callers that only need the type map; officialBlockToBlock
+	// always overrides it once it has read synced_from.
+	"synced_block": BlockTransclusionContainer,
+	"column_list":  BlockColumnList,
+	"column":       BlockColumn,
+	"table":        BlockTable,
+	"template":     BlockTemplate,
+}
+
+// blockTypeToOfficialBlockType is the reverse of officialBlockTypeToType,
+// used when writing blocks back through Mutator. Several official types
+// map to the same internal constant (e.g. "embed" and "link_preview"
+// both become BlockEmbed); ties go to whichever official name map
+// iteration reaches first. Go randomizes map iteration order, so the
+// winner is fixed once this map is built at init but can differ between
+// runs; we only need one valid round-trip, not a canonical one.
+var blockTypeToOfficialBlockType = reverseOfficialBlockTypeMap()
+
+func reverseOfficialBlockTypeMap() map[string]string {
+	m := make(map[string]string, len(officialBlockTypeToType))
+	for official, internal := range officialBlockTypeToType {
+		if _, exists := m[internal]; !exists {
+			m[internal] = official
+		}
+	}
+	// BlockTransclusionReference doesn't appear as a value in
+	// officialBlockTypeToType (see the comment on "synced_block" there),
+	// but it's still written back as a "synced_block" object.
+	m[BlockTransclusionReference] = "synced_block"
+	return m
+}
+
+// officialAnnotations is the "annotations" object attached to a rich text
+// item in the official API.
+type officialAnnotations struct {
+	Bold          bool `json:"bold"`
+	Italic        bool `json:"italic"`
+	Strikethrough bool `json:"strikethrough"`
+	Underline     bool `json:"underline"`
+	Code          bool `json:"code"`
+}
+
+// officialRichText is one entry of a "rich_text" array in the official API.
+type officialRichText struct {
+	Type        string              `json:"type"`
+	PlainText   string              `json:"plain_text"`
+	Href        string              `json:"href"`
+	Annotations officialAnnotations `json:"annotations"`
+}
+
+// officialFileOrExternal is the common shape of image/file/video/pdf/audio
+// and icon/cover payloads: either a Notion-hosted "file" or an "external" URL.
+type officialFileOrExternal struct {
+	Type string `json:"type"`
+	File struct {
+		URL string `json:"url"`
+	} `json:"file"`
+	External struct {
+		URL string `json:"url"`
+	} `json:"external"`
+}
+
+func (f officialFileOrExternal) url() string {
+	if f.Type == "external" {
+		return f.External.URL
+	}
+	return f.File.URL
+}
+
+func officialRichTextToSpans(items []officialRichText) []*TextSpan {
+	spans := make([]*TextSpan, 0, len(items))
+	for _, it := range items {
+		var attrs [][]string
+		if it.Annotations.Bold {
+			attrs = append(attrs, []string{"b"})
+		}
+		if it.Annotations.Italic {
+			attrs = append(attrs, []string{"i"})
+		}
+		if it.Annotations.Strikethrough {
+			attrs = append(attrs, []string{"s"})
+		}
+		if it.Annotations.Underline {
+			attrs = append(attrs, []string{"_"})
+		}
+		if it.Annotations.Code {
+			attrs = append(attrs, []string{"c"})
+		}
+		if it.Href != "" {
+			attrs = append(attrs, []string{"a", it.Href})
+		}
+		spans = append(spans, &TextSpan{Text: it.PlainText, Attrs: attrs})
+	}
+	return spans
+}
+
+func spansToOfficialRichText(spans []*TextSpan) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(spans))
+	for _, span := range spans {
+		ann := map[string]interface{}{}
+		href := ""
+		for _, attr := range span.Attrs {
+			if len(attr) == 0 {
+				continue
+			}
+			switch attr[0] {
+			case "b":
+				ann["bold"] = true
+			case "i":
+				ann["italic"] = true
+			case "s":
+				ann["strikethrough"] = true
+			case "_":
+				ann["underline"] = true
+			case "c":
+				ann["code"] = true
+			case "a":
+				if len(attr) > 1 {
+					href = attr[1]
+				}
+			}
+		}
+		text := map[string]interface{}{"content": span.Text}
+		if href != "" {
+			text["link"] = map[string]string{"url": href}
+		}
+		out = append(out, map[string]interface{}{
+			"type":        "text",
+			"text":        text,
+			"annotations": ann,
+		})
+	}
+	return out
+}
+
+func toNotionTimestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// officialBlockToBlock converts one element of a /v1/blocks/{id}/children
+// (or /v1/blocks/{id}) response into this package's *Block.
+func officialBlockToBlock(raw json.RawMessage) (*Block, error) {
+	var env struct {
+		ID             string    `json:"id"`
+		Type           string    `json:"type"`
+		Archived       bool      `json:"archived"`
+		CreatedTime    time.Time `json:"created_time"`
+		LastEditedTime time.Time `json:"last_edited_time"`
+	}
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, err
+	}
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+
+	b := &Block{
+		ID:             env.ID,
+		Alive:          !env.Archived,
+		Type:           officialBlockTypeToType[env.Type],
+		CreatedTime:    toNotionTimestamp(env.CreatedTime),
+		LastEditedTime: toNotionTimestamp(env.LastEditedTime),
+	}
+	if b.Type == "" {
+		// unmapped type; preserve the original name rather than losing it
+		b.Type = env.Type
+	}
+
+	body, ok := payload[env.Type]
+	if !ok {
+		return b, nil
+	}
+
+	switch env.Type {
+	case "paragraph", "heading_1", "heading_2", "heading_3",
+		"bulleted_list_item", "numbered_list_item", "quote", "callout", "toggle":
+		var v struct {
+			RichText []officialRichText `json:"rich_text"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.InlineContent = officialRichTextToSpans(v.RichText)
+	case "to_do":
+		var v struct {
+			RichText []officialRichText `json:"rich_text"`
+			Checked  bool               `json:"checked"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.InlineContent = officialRichTextToSpans(v.RichText)
+		b.IsChecked = v.Checked
+	case "code":
+		var v struct {
+			RichText []officialRichText `json:"rich_text"`
+			Language string             `json:"language"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.Code = GetInlineText(officialRichTextToSpans(v.RichText))
+		b.CodeLanguage = v.Language
+	case "equation":
+		var v struct {
+			Expression string `json:"expression"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.Equation = v.Expression
+	case "child_page", "child_database":
+		var v struct {
+			Title string `json:"title"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.Title = v.Title
+		if env.Type == "child_page" {
+			b.ParentTable = TableSpace
+		}
+	case "image", "video", "pdf", "file", "audio":
+		var v officialFileOrExternal
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.Source = v.url()
+		if env.Type == "image" {
+			b.ImageURL = v.url()
+		}
+	case "bookmark", "embed", "link_preview":
+		var v struct {
+			URL string `json:"url"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		b.Link = v.URL
+		b.Source = v.URL
+	case "synced_block":
+		var v struct {
+			SyncedFrom *struct {
+				BlockID string `json:"block_id"`
+			} `json:"synced_from"`
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		if v.SyncedFrom != nil {
+			b.Type = BlockTransclusionReference
+			b.SyncedFromBlockID = v.SyncedFrom.BlockID
+		} else {
+			b.Type = BlockTransclusionContainer
+		}
+	}
+
+	return b, nil
+}
+
+// officialPageToBlock converts a /v1/pages/{id} response into this
+// package's *Block (type BlockPage), populating FormatPage from the
+// page's icon/cover the way FormatPage.PageIcon/PageCoverURL are
+// populated for the private API.
+func officialPageToBlock(raw json.RawMessage) (*Block, error) {
+	var env struct {
+		ID             string    `json:"id"`
+		Archived       bool      `json:"archived"`
+		CreatedTime    time.Time `json:"created_time"`
+		LastEditedTime time.Time `json:"last_edited_time"`
+		Properties     map[string]struct {
+			Type  string             `json:"type"`
+			Title []officialRichText `json:"title"`
+		} `json:"properties"`
+		Icon  officialFileOrExternalWithEmoji `json:"icon"`
+		Cover officialFileOrExternal          `json:"cover"`
+	}
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, err
+	}
+
+	b := &Block{
+		ID:             env.ID,
+		Alive:          !env.Archived,
+		Type:           BlockPage,
+		ParentTable:    TableSpace,
+		CreatedTime:    toNotionTimestamp(env.CreatedTime),
+		LastEditedTime: toNotionTimestamp(env.LastEditedTime),
+	}
+
+	for _, prop := range env.Properties {
+		if prop.Type == "title" {
+			b.TitleFull = officialRichTextToSpans(prop.Title)
+			b.Title = GetInlineText(b.TitleFull)
+			break
+		}
+	}
+
+	b.FormatPage = &FormatPage{
+		PageIcon:     env.Icon.url(),
+		PageCoverURL: env.Cover.url(),
+	}
+
+	return b, nil
+}
+
+// officialFileOrExternalWithEmoji is officialFileOrExternal plus the
+// "emoji" variant pages/blocks use for icons.
+type officialFileOrExternalWithEmoji struct {
+	officialFileOrExternal
+	Emoji string `json:"emoji"`
+}
+
+func (f officialFileOrExternalWithEmoji) url() string {
+	if f.Type == "emoji" {
+		return f.Emoji
+	}
+	return f.officialFileOrExternal.url()
+}
+
+// blockToOfficialBlock converts a *Block back into the official API's
+// create-block JSON shape, for use with Mutator.AppendBlockChildren. Only
+// the rich-text-bearing subset of block types used by AppendBlockChildren
+// is supported; unmapped types return an error rather than silently
+// dropping content.
+func blockToOfficialBlock(b *Block) (json.RawMessage, error) {
+	officialType, ok := blockTypeToOfficialBlockType[b.Type]
+	if !ok {
+		return nil, fmt.Errorf("notionapi: no official API block type mapped for %q", b.Type)
+	}
+
+	var body map[string]interface{}
+	switch b.Type {
+	case BlockEquation:
+		// the official API's equation object takes a latex expression,
+		// not rich_text
+		body = map[string]interface{}{"expression": b.Equation}
+	case BlockTodo:
+		body = map[string]interface{}{
+			"rich_text": spansToOfficialRichText(b.InlineContent),
+			"checked":   b.IsChecked,
+		}
+	case BlockCode:
+		// code text is kept in Code, not InlineContent
+		body = map[string]interface{}{
+			"rich_text": spansToOfficialRichText([]*TextSpan{{Text: b.Code}}),
+			"language":  b.CodeLanguage,
+		}
+	case BlockText, BlockHeader, BlockSubHeader, BlockSubSubHeader,
+		BlockBulletedList, BlockNumberedList, BlockQuote, BlockCallout, BlockToggle:
+		body = map[string]interface{}{
+			"rich_text": spansToOfficialRichText(b.InlineContent),
+		}
+	default:
+		return nil, fmt.Errorf("notionapi: writing block type %q through the official API is not supported", b.Type)
+	}
+
+	out := map[string]interface{}{
+		"object":     "block",
+		"type":       officialType,
+		officialType: body,
+	}
+	return json.Marshal(out)
+}
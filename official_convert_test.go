@@ -0,0 +1,78 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOfficialBlockToBlockSyncedBlockContainer(t *testing.T) {
+	raw := json.RawMessage(`{
+		"id": "container-1",
+		"type": "synced_block",
+		"synced_block": {"synced_from": null}
+	}`)
+	b, err := officialBlockToBlock(raw)
+	if err != nil {
+		t.Fatalf("officialBlockToBlock failed with %s", err)
+	}
+	if b.Type != BlockTransclusionContainer {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockTransclusionContainer)
+	}
+	if b.SyncedFromBlockID != "" {
+		t.Fatalf("got SyncedFromBlockID %q, want empty", b.SyncedFromBlockID)
+	}
+}
+
+func TestOfficialBlockToBlockSyncedBlockReference(t *testing.T) {
+	raw := json.RawMessage(`{
+		"id": "ref-1",
+		"type": "synced_block",
+		"synced_block": {"synced_from": {"block_id": "container-1"}}
+	}`)
+	b, err := officialBlockToBlock(raw)
+	if err != nil {
+		t.Fatalf("officialBlockToBlock failed with %s", err)
+	}
+	if b.Type != BlockTransclusionReference {
+		t.Fatalf("got Type %q, want %q", b.Type, BlockTransclusionReference)
+	}
+	if b.SyncedFromBlockID != "container-1" {
+		t.Fatalf("got SyncedFromBlockID %q, want %q", b.SyncedFromBlockID, "container-1")
+	}
+}
+
+func TestBlockToOfficialBlockEquation(t *testing.T) {
+	b := &Block{Type: BlockEquation, Equation: "E = mc^2"}
+	raw, err := blockToOfficialBlock(b)
+	if err != nil {
+		t.Fatalf("blockToOfficialBlock failed with %s", err)
+	}
+
+	var got struct {
+		Type     string `json:"type"`
+		Equation struct {
+			Expression string `json:"expression"`
+		} `json:"equation"`
+	}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("json.Unmarshal failed with %s", err)
+	}
+	if got.Type != "equation" {
+		t.Fatalf("got type %q, want %q", got.Type, "equation")
+	}
+	if got.Equation.Expression != "E = mc^2" {
+		t.Fatalf("got expression %q, want %q", got.Equation.Expression, "E = mc^2")
+	}
+
+	var asMap map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &asMap); err != nil {
+		t.Fatalf("json.Unmarshal failed with %s", err)
+	}
+	var eqBody map[string]json.RawMessage
+	if err := json.Unmarshal(asMap["equation"], &eqBody); err != nil {
+		t.Fatalf("json.Unmarshal failed with %s", err)
+	}
+	if _, hasRichText := eqBody["rich_text"]; hasRichText {
+		t.Fatalf("equation body should not contain rich_text, got %s", raw)
+	}
+}
@@ -0,0 +1,257 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+
+	"github.com/krapshsa/notionapi"
+)
+
+// HTMLRenderer renders a block tree as a fragment of HTML (no <html>/
+// <body> wrapper; callers that need a full document should wrap the
+// result themselves).
+type HTMLRenderer struct {
+	blockHandlers
+	buf  bytes.Buffer
+	page *notionapi.Block
+}
+
+// NewHTMLRenderer returns a ready to use HTMLRenderer.
+func NewHTMLRenderer() *HTMLRenderer {
+	return &HTMLRenderer{}
+}
+
+// RenderPage renders page and everything under it, returning the HTML
+// fragment as bytes.
+func (r *HTMLRenderer) RenderPage(page *notionapi.Block) ([]byte, error) {
+	r.buf.Reset()
+	r.page = page
+	for _, b := range page.Content {
+		if err := r.RenderBlock(b, 0); err != nil {
+			return nil, err
+		}
+	}
+	return r.buf.Bytes(), nil
+}
+
+// RenderBlock renders a single block and, where appropriate, recurses
+// into its children.
+func (r *HTMLRenderer) RenderBlock(b *notionapi.Block, depth int) error {
+	if handled, err := r.blockHandlers.dispatch(r, b, depth); handled {
+		return err
+	}
+
+	switch b.Type {
+	case notionapi.BlockText:
+		r.buf.WriteString("<p>")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("</p>\n")
+	case notionapi.BlockHeader:
+		r.wrapInline("h1", b.InlineContent)
+	case notionapi.BlockSubHeader:
+		r.wrapInline("h2", b.InlineContent)
+	case notionapi.BlockSubSubHeader:
+		r.wrapInline("h3", b.InlineContent)
+	case notionapi.BlockQuote:
+		r.wrapInline("blockquote", b.InlineContent)
+	case notionapi.BlockBulletedList:
+		r.buf.WriteString("<li>")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("</li>\n")
+	case notionapi.BlockNumberedList:
+		r.buf.WriteString("<li>")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("</li>\n")
+	case notionapi.BlockTodo:
+		checked := ""
+		if b.IsChecked {
+			checked = " checked"
+		}
+		fmt.Fprintf(&r.buf, "<input type=\"checkbox\" disabled%s> ", checked)
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("<br/>\n")
+	case notionapi.BlockDivider:
+		r.buf.WriteString("<hr/>\n")
+		return nil
+	case notionapi.BlockCode:
+		lang := html.EscapeString(b.CodeLanguage)
+		fmt.Fprintf(&r.buf, "<pre><code class=\"language-%s\">%s</code></pre>\n", lang, html.EscapeString(b.Code))
+		return nil
+	case notionapi.BlockImage:
+		fmt.Fprintf(&r.buf, "<img src=\"%s\"/>\n", html.EscapeString(b.ImageURL))
+		return nil
+	case notionapi.BlockBookmark:
+		fmt.Fprintf(&r.buf, "<a href=\"%s\">%s</a>\n", html.EscapeString(b.Link), html.EscapeString(b.Title))
+		return nil
+	case notionapi.BlockToggle:
+		r.buf.WriteString("<details><summary>")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("</summary>\n")
+		for _, child := range b.Content {
+			if err := r.RenderBlock(child, depth+1); err != nil {
+				return err
+			}
+		}
+		r.buf.WriteString("</details>\n")
+		return nil
+	case notionapi.BlockColumnList:
+		r.buf.WriteString("<div class=\"notion-column-list\">\n")
+		for _, child := range b.Content {
+			if err := r.RenderBlock(child, depth+1); err != nil {
+				return err
+			}
+		}
+		r.buf.WriteString("</div>\n")
+		return nil
+	case notionapi.BlockColumn:
+		ratio := 0.0
+		if b.FormatColumn != nil {
+			ratio = b.FormatColumn.ColumnRation
+		}
+		fmt.Fprintf(&r.buf, "<div class=\"notion-column\" style=\"width:%.2f%%\">\n", ratio*100)
+		for _, child := range b.Content {
+			if err := r.RenderBlock(child, depth+1); err != nil {
+				return err
+			}
+		}
+		r.buf.WriteString("</div>\n")
+		return nil
+	case notionapi.BlockCallout:
+		r.wrapInline("blockquote", b.InlineContent)
+	case notionapi.BlockEquation:
+		fmt.Fprintf(&r.buf, "<p class=\"notion-equation\">\\[%s\\]</p>\n", html.EscapeString(b.Equation))
+		return nil
+	case notionapi.BlockVideo:
+		r.renderLinkLike("video", b.Source)
+		return nil
+	case notionapi.BlockFile:
+		label := b.Title
+		if label == "" {
+			label = "file"
+		}
+		r.renderLinkLike(label, b.Source)
+		return nil
+	case notionapi.BlockPDF:
+		r.renderLinkLike("pdf", b.Source)
+		return nil
+	case notionapi.BlockAudio:
+		r.renderLinkLike("audio", b.Source)
+		return nil
+	case notionapi.BlockGist:
+		r.renderLinkLike("gist", b.Source)
+		return nil
+	case notionapi.BlockTweet:
+		r.renderLinkLike("tweet", b.Source)
+		return nil
+	case notionapi.BlockEmbed:
+		r.renderLinkLike("embed", b.Source)
+		return nil
+	case notionapi.BlockLinkToPage:
+		title := b.Title
+		if title == "" {
+			title = b.LinkedPageID
+		}
+		fmt.Fprintf(&r.buf, "<a href=\"#%s\">%s</a>\n", b.LinkedPageID, html.EscapeString(title))
+		return nil
+	case notionapi.BlockTableOfContents:
+		r.buf.WriteString("<ul class=\"notion-toc\">\n")
+		for _, h := range buildTOC(r.page) {
+			r.buf.WriteString("<li>")
+			if err := r.RenderInline(h.InlineContent); err != nil {
+				return err
+			}
+			r.buf.WriteString("</li>\n")
+		}
+		r.buf.WriteString("</ul>\n")
+		return nil
+	case notionapi.BlockPage,
+		notionapi.BlockTable, notionapi.BlockCollectionView,
+		notionapi.BlockBreadcrumb, notionapi.BlockTemplate,
+		notionapi.BlockChildDatabase, notionapi.BlockTransclusionContainer,
+		notionapi.BlockTransclusionReference, notionapi.BlockComment:
+		// no markup of their own (or not enough structure in this
+		// chunk to render meaningfully); just recurse below
+	default:
+		return errUnsupportedBlock(b)
+	}
+
+	for _, child := range b.Content {
+		if err := r.RenderBlock(child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *HTMLRenderer) wrapInline(tag string, spans []*notionapi.TextSpan) error {
+	fmt.Fprintf(&r.buf, "<%s>", tag)
+	if err := r.RenderInline(spans); err != nil {
+		return err
+	}
+	fmt.Fprintf(&r.buf, "</%s>\n", tag)
+	return nil
+}
+
+// renderLinkLike writes an HTML link for blocks whose only meaningful
+// content in this chunk is a label and a URL (embeds, gists, tweets,
+// videos, files, audio).
+func (r *HTMLRenderer) renderLinkLike(label, url string) {
+	fmt.Fprintf(&r.buf, "<a href=\"%s\">%s</a>\n", html.EscapeString(url), html.EscapeString(label))
+}
+
+// RenderInline writes spans as HTML inline markup directly to the
+// renderer's buffer, escaping text content.
+func (r *HTMLRenderer) RenderInline(spans []*notionapi.TextSpan) error {
+	for _, span := range spans {
+		text := html.EscapeString(span.Text)
+		var href string
+		bold, italic, code, strike := false, false, false, false
+		for _, attr := range span.Attrs {
+			if len(attr) == 0 {
+				continue
+			}
+			switch attr[0] {
+			case "b":
+				bold = true
+			case "i":
+				italic = true
+			case "c":
+				code = true
+			case "s":
+				strike = true
+			case "a":
+				if len(attr) > 1 {
+					href = attr[1]
+				}
+			}
+		}
+		if code {
+			text = "<code>" + text + "</code>"
+		}
+		if bold {
+			text = "<b>" + text + "</b>"
+		}
+		if italic {
+			text = "<i>" + text + "</i>"
+		}
+		if strike {
+			text = "<s>" + text + "</s>"
+		}
+		if href != "" {
+			text = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(href), text)
+		}
+		r.buf.WriteString(text)
+	}
+	return nil
+}
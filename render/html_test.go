@@ -0,0 +1,61 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krapshsa/notionapi"
+)
+
+func TestHTMLRenderBlockEscapesAttributeValues(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockBookmark, Title: "evil", Link: `x" onmouseover="alert(1)`},
+		},
+	}
+	r := NewHTMLRenderer()
+	out, err := r.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	got := string(out)
+	if strings.Contains(got, `onmouseover="alert(1)"`) {
+		t.Fatalf("attribute value was not escaped, got %s", got)
+	}
+	if !strings.Contains(got, `href="x&#34; onmouseover=&#34;alert(1)"`) {
+		t.Fatalf("expected escaped href, got %s", got)
+	}
+}
+
+func TestHTMLRenderBlockCommentDoesNotError(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockComment},
+		},
+	}
+	r := NewHTMLRenderer()
+	if _, err := r.RenderPage(page); err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+}
+
+func TestHTMLRenderInlineEscapesHref(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{
+				Type: notionapi.BlockText,
+				InlineContent: []*notionapi.TextSpan{
+					{Text: "link", Attrs: [][]string{{"a", `x" onmouseover="alert(1)`}}},
+				},
+			},
+		},
+	}
+	r := NewHTMLRenderer()
+	out, err := r.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	if strings.Contains(string(out), `onmouseover="alert(1)"`) {
+		t.Fatalf("inline href was not escaped, got %s", out)
+	}
+}
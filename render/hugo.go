@@ -0,0 +1,107 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/krapshsa/notionapi"
+)
+
+// HugoFrontMatterFormat selects how HugoRenderer encodes front matter.
+type HugoFrontMatterFormat int
+
+const (
+	// HugoFrontMatterYAML wraps front matter in "---" delimiters.
+	HugoFrontMatterYAML HugoFrontMatterFormat = iota
+	// HugoFrontMatterTOML wraps front matter in "+++" delimiters.
+	HugoFrontMatterTOML
+)
+
+// HugoRenderer renders a page as a Hugo content file: front matter
+// derived from FormatPage, followed by a Markdown body.
+type HugoRenderer struct {
+	// FrontMatterFormat selects YAML (default) or TOML front matter.
+	FrontMatterFormat HugoFrontMatterFormat
+	md                *MarkdownRenderer
+}
+
+// NewHugoRenderer returns a ready to use HugoRenderer.
+func NewHugoRenderer() *HugoRenderer {
+	return &HugoRenderer{md: NewMarkdownRenderer()}
+}
+
+// RenderPage renders page's front matter and body, returning the
+// complete Hugo content file as bytes.
+func (r *HugoRenderer) RenderPage(page *notionapi.Block) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := r.writeFrontMatter(&buf, page); err != nil {
+		return nil, err
+	}
+	body, err := r.md.RenderPage(page)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// RenderBlock delegates to the underlying Markdown renderer; front matter
+// is only emitted by RenderPage.
+func (r *HugoRenderer) RenderBlock(b *notionapi.Block, depth int) error {
+	return r.md.RenderBlock(b, depth)
+}
+
+// RenderInline delegates to the underlying Markdown renderer.
+func (r *HugoRenderer) RenderInline(spans []*notionapi.TextSpan) error {
+	return r.md.RenderInline(spans)
+}
+
+// RegisterBlockHandler overrides (or adds) the render function this
+// renderer's Markdown body uses for blockType. Scoped to this
+// HugoRenderer instance, same as MarkdownRenderer.RegisterBlockHandler.
+func (r *HugoRenderer) RegisterBlockHandler(blockType string, fn RenderFunc) {
+	r.md.RegisterBlockHandler(blockType, fn)
+}
+
+func (r *HugoRenderer) writeFrontMatter(buf *bytes.Buffer, page *notionapi.Block) error {
+	title := notionapi.GetInlineText(page.TitleFull)
+	if title == "" {
+		title = page.Title
+	}
+
+	var icon, cover string
+	if page.FormatPage != nil {
+		icon = page.FormatPage.PageIcon
+		cover = page.FormatPage.PageCoverURL
+	}
+	created := page.CreatedOn()
+	updated := page.UpdatedOn()
+
+	switch r.FrontMatterFormat {
+	case HugoFrontMatterTOML:
+		buf.WriteString("+++\n")
+		fmt.Fprintf(buf, "title = %q\n", title)
+		if icon != "" {
+			fmt.Fprintf(buf, "page_icon = %q\n", icon)
+		}
+		if cover != "" {
+			fmt.Fprintf(buf, "page_cover_url = %q\n", cover)
+		}
+		fmt.Fprintf(buf, "date = %q\n", created.Format("2006-01-02T15:04:05Z07:00"))
+		fmt.Fprintf(buf, "lastmod = %q\n", updated.Format("2006-01-02T15:04:05Z07:00"))
+		buf.WriteString("+++\n\n")
+	default:
+		buf.WriteString("---\n")
+		fmt.Fprintf(buf, "title: %q\n", title)
+		if icon != "" {
+			fmt.Fprintf(buf, "page_icon: %q\n", icon)
+		}
+		if cover != "" {
+			fmt.Fprintf(buf, "page_cover_url: %q\n", cover)
+		}
+		fmt.Fprintf(buf, "date: %q\n", created.Format("2006-01-02T15:04:05Z07:00"))
+		fmt.Fprintf(buf, "lastmod: %q\n", updated.Format("2006-01-02T15:04:05Z07:00"))
+		buf.WriteString("---\n\n")
+	}
+	return nil
+}
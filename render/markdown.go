@@ -0,0 +1,248 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/krapshsa/notionapi"
+)
+
+// MarkdownRenderer renders a block tree as GitHub-flavored Markdown.
+type MarkdownRenderer struct {
+	blockHandlers
+	buf  bytes.Buffer
+	page *notionapi.Block
+}
+
+// NewMarkdownRenderer returns a ready to use MarkdownRenderer.
+func NewMarkdownRenderer() *MarkdownRenderer {
+	return &MarkdownRenderer{}
+}
+
+// RenderPage renders page and everything under it, returning the
+// Markdown document as bytes.
+func (r *MarkdownRenderer) RenderPage(page *notionapi.Block) ([]byte, error) {
+	r.buf.Reset()
+	r.page = page
+	if page.FormatPage != nil && len(page.TitleFull) > 0 {
+		r.buf.WriteString("# ")
+		if err := r.RenderInline(page.TitleFull); err != nil {
+			return nil, err
+		}
+		r.buf.WriteString("\n\n")
+	}
+	for _, b := range page.Content {
+		if err := r.RenderBlock(b, 0); err != nil {
+			return nil, err
+		}
+	}
+	return r.buf.Bytes(), nil
+}
+
+// RenderBlock renders a single block and, where appropriate, recurses
+// into its children.
+func (r *MarkdownRenderer) RenderBlock(b *notionapi.Block, depth int) error {
+	if handled, err := r.blockHandlers.dispatch(r, b, depth); handled {
+		return err
+	}
+
+	switch b.Type {
+	case notionapi.BlockText:
+		indent(&r.buf, depth)
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("\n\n")
+	case notionapi.BlockHeader:
+		r.buf.WriteString("# ")
+		r.renderInlineAndBreak(b.InlineContent)
+	case notionapi.BlockSubHeader:
+		r.buf.WriteString("## ")
+		r.renderInlineAndBreak(b.InlineContent)
+	case notionapi.BlockSubSubHeader:
+		r.buf.WriteString("### ")
+		r.renderInlineAndBreak(b.InlineContent)
+	case notionapi.BlockQuote:
+		r.buf.WriteString("> ")
+		r.renderInlineAndBreak(b.InlineContent)
+	case notionapi.BlockBulletedList:
+		indent(&r.buf, depth)
+		r.buf.WriteString("* ")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("\n")
+	case notionapi.BlockNumberedList:
+		indent(&r.buf, depth)
+		r.buf.WriteString("1. ")
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("\n")
+	case notionapi.BlockTodo:
+		indent(&r.buf, depth)
+		if b.IsChecked {
+			r.buf.WriteString("- [x] ")
+		} else {
+			r.buf.WriteString("- [ ] ")
+		}
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("\n")
+	case notionapi.BlockDivider:
+		r.buf.WriteString("---\n\n")
+		return nil
+	case notionapi.BlockCode:
+		lang := b.CodeLanguage
+		fmt.Fprintf(&r.buf, "```%s\n%s\n```\n\n", lang, b.Code)
+		return nil
+	case notionapi.BlockImage:
+		fmt.Fprintf(&r.buf, "![](%s)\n\n", b.ImageURL)
+		return nil
+	case notionapi.BlockBookmark:
+		fmt.Fprintf(&r.buf, "[%s](%s)\n\n", b.Title, b.Link)
+		return nil
+	case notionapi.BlockToggle:
+		if err := r.RenderInline(b.InlineContent); err != nil {
+			return err
+		}
+		r.buf.WriteString("\n\n")
+	case notionapi.BlockCallout:
+		r.buf.WriteString("> ")
+		r.renderInlineAndBreak(b.InlineContent)
+	case notionapi.BlockColumn:
+		ratio := 0.0
+		if b.FormatColumn != nil {
+			ratio = b.FormatColumn.ColumnRation
+		}
+		fmt.Fprintf(&r.buf, "<!-- column width=%.2f%% -->\n", ratio*100)
+	case notionapi.BlockEquation:
+		fmt.Fprintf(&r.buf, "$$\n%s\n$$\n\n", b.Equation)
+		return nil
+	case notionapi.BlockVideo:
+		r.renderLinkLike("video", b.Source)
+		return nil
+	case notionapi.BlockFile:
+		label := b.Title
+		if label == "" {
+			label = "file"
+		}
+		r.renderLinkLike(label, b.Source)
+		return nil
+	case notionapi.BlockPDF:
+		r.renderLinkLike("pdf", b.Source)
+		return nil
+	case notionapi.BlockAudio:
+		r.renderLinkLike("audio", b.Source)
+		return nil
+	case notionapi.BlockGist:
+		r.renderLinkLike("gist", b.Source)
+		return nil
+	case notionapi.BlockTweet:
+		r.renderLinkLike("tweet", b.Source)
+		return nil
+	case notionapi.BlockEmbed:
+		r.renderLinkLike("embed", b.Source)
+		return nil
+	case notionapi.BlockLinkToPage:
+		title := b.Title
+		if title == "" {
+			title = b.LinkedPageID
+		}
+		fmt.Fprintf(&r.buf, "[%s](#%s)\n\n", title, b.LinkedPageID)
+		return nil
+	case notionapi.BlockTableOfContents:
+		for _, h := range buildTOC(r.page) {
+			depth := 0
+			switch h.Type {
+			case notionapi.BlockSubHeader:
+				depth = 1
+			case notionapi.BlockSubSubHeader:
+				depth = 2
+			}
+			indent(&r.buf, depth)
+			r.buf.WriteString("* ")
+			if err := r.RenderInline(h.InlineContent); err != nil {
+				return err
+			}
+			r.buf.WriteString("\n")
+		}
+		r.buf.WriteString("\n")
+		return nil
+	case notionapi.BlockColumnList, notionapi.BlockPage,
+		notionapi.BlockTable, notionapi.BlockCollectionView,
+		notionapi.BlockBreadcrumb, notionapi.BlockTemplate,
+		notionapi.BlockChildDatabase, notionapi.BlockTransclusionContainer,
+		notionapi.BlockTransclusionReference, notionapi.BlockComment:
+		// no markup of their own (or not enough structure in this
+		// chunk to render meaningfully), just recurse below
+	default:
+		return errUnsupportedBlock(b)
+	}
+
+	for _, child := range b.Content {
+		if err := r.RenderBlock(child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MarkdownRenderer) renderInlineAndBreak(spans []*notionapi.TextSpan) {
+	r.RenderInline(spans)
+	r.buf.WriteString("\n\n")
+}
+
+// renderLinkLike writes a Markdown link block for blocks whose only
+// meaningful content in this chunk is a label and a URL (embeds, gists,
+// tweets, videos, files, audio).
+func (r *MarkdownRenderer) renderLinkLike(label, url string) {
+	fmt.Fprintf(&r.buf, "[%s](%s)\n\n", label, url)
+}
+
+// RenderInline writes spans as Markdown inline markup (bold, italic,
+// code, links) directly to the renderer's buffer.
+func (r *MarkdownRenderer) RenderInline(spans []*notionapi.TextSpan) error {
+	for _, span := range spans {
+		text := span.Text
+		var href string
+		bold, italic, code, strike := false, false, false, false
+		for _, attr := range span.Attrs {
+			if len(attr) == 0 {
+				continue
+			}
+			switch attr[0] {
+			case "b":
+				bold = true
+			case "i":
+				italic = true
+			case "c":
+				code = true
+			case "s":
+				strike = true
+			case "a":
+				if len(attr) > 1 {
+					href = attr[1]
+				}
+			}
+		}
+		if code {
+			text = "`" + text + "`"
+		}
+		if bold {
+			text = "**" + text + "**"
+		}
+		if italic {
+			text = "_" + text + "_"
+		}
+		if strike {
+			text = "~~" + text + "~~"
+		}
+		if href != "" {
+			text = "[" + text + "](" + href + ")"
+		}
+		r.buf.WriteString(text)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krapshsa/notionapi"
+)
+
+func TestMarkdownRenderPageBasic(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockHeader, InlineContent: []*notionapi.TextSpan{{Text: "Title"}}},
+			{Type: notionapi.BlockText, InlineContent: []*notionapi.TextSpan{{Text: "hello"}}},
+		},
+	}
+	r := NewMarkdownRenderer()
+	out, err := r.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	got := string(out)
+	if !strings.Contains(got, "# Title") || !strings.Contains(got, "hello") {
+		t.Fatalf("got %q, want it to contain heading and text", got)
+	}
+}
+
+func TestMarkdownRenderBlockCommentDoesNotError(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockComment},
+		},
+	}
+	r := NewMarkdownRenderer()
+	if _, err := r.RenderPage(page); err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+}
+
+func TestMarkdownRenderBlockColumn(t *testing.T) {
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockColumn, FormatColumn: &notionapi.FormatColumn{ColumnRation: 0.5}},
+		},
+	}
+	r := NewMarkdownRenderer()
+	out, err := r.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	if !strings.Contains(string(out), "width=50.00%") {
+		t.Fatalf("got %q, want column width comment", out)
+	}
+}
+
+func TestRegisterBlockHandlerIsPerInstance(t *testing.T) {
+	md1 := NewMarkdownRenderer()
+	md2 := NewMarkdownRenderer()
+	md1.RegisterBlockHandler(notionapi.BlockText, func(r Renderer, b *notionapi.Block, depth int) error {
+		r.(*MarkdownRenderer).buf.WriteString("custom\n")
+		return nil
+	})
+
+	page := &notionapi.Block{
+		Content: []*notionapi.Block{
+			{Type: notionapi.BlockText, InlineContent: []*notionapi.TextSpan{{Text: "hello"}}},
+		},
+	}
+
+	out1, err := md1.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	if !strings.Contains(string(out1), "custom") {
+		t.Fatalf("got %q, want registered handler output", out1)
+	}
+
+	out2, err := md2.RenderPage(page)
+	if err != nil {
+		t.Fatalf("RenderPage failed with %s", err)
+	}
+	if strings.Contains(string(out2), "custom") {
+		t.Fatalf("got %q, handler registered on md1 leaked into md2", out2)
+	}
+}
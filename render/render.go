@@ -0,0 +1,101 @@
+// Package render turns a resolved notionapi.Block tree into output formats
+// (Markdown, HTML, Hugo content files). It knows nothing about how the
+// page was downloaded; it only walks Block.Content and Block.InlineContent.
+package render
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/krapshsa/notionapi"
+)
+
+// WalkAction is not used by this package and declares no values of its
+// own; the actions notionapi.Walk understands are notionapi.WalkContinue,
+// notionapi.WalkSkipChildren and notionapi.WalkStop.
+type WalkAction int
+
+// RenderFunc renders a single block at a given depth. depth is 0 for the
+// page's top-level content and increases by one per nesting level
+// (e.g. a bulleted list inside a toggle).
+type RenderFunc func(r Renderer, b *notionapi.Block, depth int) error
+
+// Renderer turns a block tree into bytes. Implementations are expected to
+// be stateful (they accumulate output in an internal buffer) and are not
+// safe for concurrent use.
+type Renderer interface {
+	// RenderBlock renders a single block, recursing into its children
+	// as appropriate for that block's type.
+	RenderBlock(b *notionapi.Block, depth int) error
+	// RenderInline renders a list of text spans (e.g. a paragraph's
+	// text, a heading's title) without any block-level wrapping.
+	RenderInline(spans []*notionapi.TextSpan) error
+	// RenderPage renders the full page, including front matter if the
+	// implementation produces any, and returns the final output.
+	RenderPage(page *notionapi.Block) ([]byte, error)
+}
+
+// blockHandlers holds one Renderer instance's custom RenderFunc
+// overrides, keyed by Block.Type. Each concrete Renderer embeds its own
+// blockHandlers (rather than sharing package-level state), so registering
+// a custom handler on one MarkdownRenderer instance never affects any
+// other MarkdownRenderer, HTMLRenderer or HugoRenderer in the same process.
+type blockHandlers struct {
+	handlers map[string]RenderFunc
+}
+
+// RegisterBlockHandler overrides (or adds) the render function this
+// renderer uses for blockType. Use this to customize rendering of e.g.
+// notionapi.BlockCode or notionapi.BlockCallout without forking the
+// built-in renderers.
+func (h *blockHandlers) RegisterBlockHandler(blockType string, fn RenderFunc) {
+	if h.handlers == nil {
+		h.handlers = map[string]RenderFunc{}
+	}
+	h.handlers[blockType] = fn
+}
+
+// dispatch calls the registered handler for b, if any, and reports
+// whether one was found.
+func (h *blockHandlers) dispatch(r Renderer, b *notionapi.Block, depth int) (bool, error) {
+	fn := h.handlers[b.Type]
+	if fn == nil {
+		return false, nil
+	}
+	return true, fn(r, b, depth)
+}
+
+// buildTOC collects the heading blocks (BlockHeader, BlockSubHeader,
+// BlockSubSubHeader) found anywhere under root, in document order. The
+// built-in BlockTableOfContents cases use it to list the text of the
+// page's own headings.
+func buildTOC(root *notionapi.Block) []*notionapi.Block {
+	var headings []*notionapi.Block
+	var walk func(b *notionapi.Block)
+	walk = func(b *notionapi.Block) {
+		switch b.Type {
+		case notionapi.BlockHeader, notionapi.BlockSubHeader, notionapi.BlockSubSubHeader:
+			headings = append(headings, b)
+		}
+		for _, child := range b.Content {
+			walk(child)
+		}
+	}
+	for _, child := range root.Content {
+		walk(child)
+	}
+	return headings
+}
+
+// errUnsupportedBlock is returned when a block type has no registered
+// handler and the renderer doesn't have a built-in fallback for it.
+func errUnsupportedBlock(b *notionapi.Block) error {
+	return fmt.Errorf("render: no handler for block type %q (id %s)", b.Type, b.ID)
+}
+
+// indent writes n levels of indentation (two spaces each) to buf.
+func indent(buf *bytes.Buffer, depth int) {
+	for i := 0; i < depth; i++ {
+		buf.WriteString("  ")
+	}
+}
@@ -0,0 +1,143 @@
+package notionapi
+
+import "errors"
+
+// WalkAction tells Walk what to do after a Visitor.Enter call.
+type WalkAction int
+
+const (
+	// WalkContinue descends into the current block's Content.
+	WalkContinue WalkAction = iota
+	// WalkSkipChildren skips the current block's Content but continues
+	// the walk with its siblings.
+	WalkSkipChildren
+	// WalkStop aborts the walk entirely. Walk returns nil.
+	WalkStop
+)
+
+// Visitor is called for every block visited by Walk.
+type Visitor interface {
+	// Enter is called before a block's children are visited.
+	Enter(b *Block) (WalkAction, error)
+	// Leave is called after a block's children have been visited (or
+	// immediately after Enter if it returned WalkSkipChildren/WalkStop).
+	Leave(b *Block) error
+}
+
+// WalkOptions controls how Walk traverses the block tree.
+type WalkOptions struct {
+	// FollowPageLinks makes Walk recurse into blocks whose GetPageType
+	// is BlockPageLink. Off by default: a page link points at a block
+	// that is (or can be) an ancestor elsewhere in the tree, so blindly
+	// recursing into it risks infinite loops.
+	FollowPageLinks bool
+}
+
+var errWalkStopped = errors.New("notionapi: walk stopped")
+
+// Walk traverses the tree rooted at root (which must already have its
+// Content resolved), calling visitor.Enter/Leave for each block.
+func Walk(root *Block, visitor Visitor) error {
+	return WalkOpts(root, visitor, WalkOptions{})
+}
+
+// WalkOpts is like Walk but lets the caller override WalkOptions.
+func WalkOpts(root *Block, visitor Visitor, opts WalkOptions) error {
+	err := walkBlock(root, visitor, opts)
+	if err == errWalkStopped {
+		return nil
+	}
+	return err
+}
+
+func walkBlock(b *Block, visitor Visitor, opts WalkOptions) error {
+	action, err := visitor.Enter(b)
+	if err != nil {
+		return err
+	}
+	switch action {
+	case WalkStop:
+		if err := visitor.Leave(b); err != nil {
+			return err
+		}
+		return errWalkStopped
+	case WalkSkipChildren:
+		return visitor.Leave(b)
+	}
+
+	for _, child := range b.Content {
+		if !opts.FollowPageLinks && child.GetPageType() == BlockPageLink {
+			continue
+		}
+		if err := walkBlock(child, visitor, opts); err != nil {
+			return err
+		}
+	}
+	return visitor.Leave(b)
+}
+
+// nopLeaveVisitor can be embedded by visitors that only need Enter.
+type nopLeaveVisitor struct{}
+
+func (nopLeaveVisitor) Leave(b *Block) error { return nil }
+
+// ImageCollector is a Visitor that gathers every image URL reachable
+// from the walked root: BlockImage.ImageURL and FormatPage.PageCoverURL.
+// Useful for mirroring a page's images for offline/CDN hosting.
+type ImageCollector struct {
+	nopLeaveVisitor
+	URLs []string
+}
+
+// Enter implements Visitor.
+func (v *ImageCollector) Enter(b *Block) (WalkAction, error) {
+	if b.IsImage() && b.ImageURL != "" {
+		v.URLs = append(v.URLs, b.ImageURL)
+	}
+	if b.FormatPage != nil && b.FormatPage.PageCoverURL != "" {
+		v.URLs = append(v.URLs, b.FormatPage.PageCoverURL)
+	}
+	return WalkContinue, nil
+}
+
+// HeadingCollector is a Visitor that gathers, in document order, every
+// BlockHeader/BlockSubHeader/BlockSubSubHeader block under the walked
+// root: the same headings a BlockTableOfContents lists.
+type HeadingCollector struct {
+	nopLeaveVisitor
+	Headings []*Block
+}
+
+// Enter implements Visitor.
+func (v *HeadingCollector) Enter(b *Block) (WalkAction, error) {
+	switch b.Type {
+	case BlockHeader, BlockSubHeader, BlockSubSubHeader:
+		v.Headings = append(v.Headings, b)
+	}
+	return WalkContinue, nil
+}
+
+// URLRewriter is a Visitor that rewrites Source, ImageURL and
+// FormatPage.PageCoverURL in place using Rewrite, e.g. to point at a
+// CDN or proxy instead of the hard-coded maybeProxyImageURL behavior.
+type URLRewriter struct {
+	nopLeaveVisitor
+	Rewrite func(url string) string
+}
+
+// Enter implements Visitor.
+func (v *URLRewriter) Enter(b *Block) (WalkAction, error) {
+	if b.Source != "" {
+		b.Source = v.Rewrite(b.Source)
+	}
+	if b.ImageURL != "" {
+		b.ImageURL = v.Rewrite(b.ImageURL)
+	}
+	if b.FormatPage != nil && b.FormatPage.PageCoverURL != "" {
+		b.FormatPage.PageCoverURL = v.Rewrite(b.FormatPage.PageCoverURL)
+	}
+	if b.FormatImage != nil && b.FormatImage.ImageURL != "" {
+		b.FormatImage.ImageURL = v.Rewrite(b.FormatImage.ImageURL)
+	}
+	return WalkContinue, nil
+}
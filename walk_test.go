@@ -0,0 +1,82 @@
+package notionapi
+
+import "testing"
+
+// recordingVisitor records the order of Enter/Leave calls and can be told
+// to stop or skip children at a particular block.
+type recordingVisitor struct {
+	order  []string
+	stopAt string
+	skipAt string
+}
+
+func (v *recordingVisitor) Enter(b *Block) (WalkAction, error) {
+	v.order = append(v.order, "enter:"+b.ID)
+	switch b.ID {
+	case v.stopAt:
+		return WalkStop, nil
+	case v.skipAt:
+		return WalkSkipChildren, nil
+	}
+	return WalkContinue, nil
+}
+
+func (v *recordingVisitor) Leave(b *Block) error {
+	v.order = append(v.order, "leave:"+b.ID)
+	return nil
+}
+
+func buildWalkTestTree() *Block {
+	child1 := &Block{ID: "child1"}
+	child2 := &Block{ID: "child2"}
+	root := &Block{ID: "root", Content: []*Block{child1, child2}}
+	return root
+}
+
+func TestWalkVisitsEveryBlockInOrder(t *testing.T) {
+	root := buildWalkTestTree()
+	v := &recordingVisitor{}
+	if err := Walk(root, v); err != nil {
+		t.Fatalf("Walk failed with %s", err)
+	}
+	want := []string{"enter:root", "enter:child1", "leave:child1", "enter:child2", "leave:child2", "leave:root"}
+	if !equalStrings(v.order, want) {
+		t.Fatalf("got order %v, want %v", v.order, want)
+	}
+}
+
+func TestWalkSkipChildrenStillCallsLeave(t *testing.T) {
+	root := buildWalkTestTree()
+	v := &recordingVisitor{skipAt: "root"}
+	if err := Walk(root, v); err != nil {
+		t.Fatalf("Walk failed with %s", err)
+	}
+	want := []string{"enter:root", "leave:root"}
+	if !equalStrings(v.order, want) {
+		t.Fatalf("got order %v, want %v", v.order, want)
+	}
+}
+
+func TestWalkStopCallsLeaveOnTheStoppingBlock(t *testing.T) {
+	root := buildWalkTestTree()
+	v := &recordingVisitor{stopAt: "child1"}
+	if err := Walk(root, v); err != nil {
+		t.Fatalf("Walk failed with %s", err)
+	}
+	want := []string{"enter:root", "enter:child1", "leave:child1"}
+	if !equalStrings(v.order, want) {
+		t.Fatalf("got order %v, want %v", v.order, want)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}